@@ -86,6 +86,12 @@ description: >
 			metric.subsystem = "controller_runtime"
 			metric.name = strings.TrimPrefix(metric.name, "controller_runtime_")
 		}
+		// Other metrics without a subsystem (e.g. karpenter_build_info) are grouped by the first segment of their name
+		if metric.subsystem == "" {
+			if parts := strings.SplitN(metric.name, "_", 2); len(parts) == 2 {
+				metric.subsystem, metric.name = parts[0], parts[1]
+			}
+		}
 		if metric.subsystem != previousSubsystem {
 			subsystemTitle := strings.Join(lo.Map(strings.Split(metric.subsystem, "_"), func(s string, _ int) string {
 				return fmt.Sprintf("%s%s", strings.ToTitle(s[0:1]), s[1:])
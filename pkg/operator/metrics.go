@@ -0,0 +1,66 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package operator
+
+import (
+	"runtime"
+	"runtime/debug"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/samber/lo"
+	crmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+
+	"github.com/aws/karpenter-core/pkg/metrics"
+	"github.com/aws/karpenter-core/pkg/operator"
+)
+
+var (
+	VersionLabel   = "version"
+	CommitLabel    = "commit"
+	GoVersionLabel = "goversion"
+	BuildInfo      = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: metrics.Namespace,
+			Name:      "build_info",
+			Help:      "A metric with a constant '1' value labeled by the version, commit and Go version from which Karpenter was built.",
+		},
+		[]string{
+			VersionLabel,
+			CommitLabel,
+			GoVersionLabel,
+		})
+)
+
+func init() {
+	crmetrics.Registry.MustRegister(BuildInfo)
+	BuildInfo.With(prometheus.Labels{
+		VersionLabel:   operator.Version,
+		CommitLabel:    commit(),
+		GoVersionLabel: runtime.Version(),
+	}).Set(1)
+}
+
+// commit returns the VCS revision stamped into the binary by the Go toolchain
+func commit() string {
+	info, ok := debug.ReadBuildInfo()
+	if !ok {
+		return "unspecified"
+	}
+	setting, ok := lo.Find(info.Settings, func(s debug.BuildSetting) bool { return s.Key == "vcs.revision" })
+	if !ok {
+		return "unspecified"
+	}
+	return setting.Value
+}
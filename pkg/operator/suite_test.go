@@ -17,6 +17,7 @@ package operator_test
 import (
 	"context"
 	"errors"
+	"runtime"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/service/eks"
@@ -31,6 +32,7 @@ import (
 	"github.com/aws/karpenter/pkg/operator/options"
 	"github.com/aws/karpenter/pkg/test"
 
+	"github.com/aws/karpenter-core/pkg/operator"
 	"github.com/aws/karpenter-core/pkg/operator/scheme"
 	coretest "github.com/aws/karpenter-core/pkg/test"
 	. "github.com/aws/karpenter-core/pkg/test/expectations"
@@ -101,4 +103,12 @@ var _ = Describe("Operator", func() {
 		_, err := awscontext.ResolveClusterEndpoint(ctx, fakeEKSAPI)
 		Expect(err).To(HaveOccurred())
 	})
+	It("should emit build info with the injected version", func() {
+		metric, ok := FindMetricWithLabelValues("karpenter_build_info", map[string]string{
+			awscontext.VersionLabel:   operator.Version,
+			awscontext.GoVersionLabel: runtime.Version(),
+		})
+		Expect(ok).To(BeTrue())
+		Expect(metric.GetGauge().GetValue()).To(BeNumerically("==", 1))
+	})
 })